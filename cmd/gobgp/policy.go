@@ -75,6 +75,12 @@ func prettyString(v interface{}) string {
 			typ = "<="
 		}
 		return fmt.Sprintf("%s%d", typ, a.Length)
+	case *api.SourceRate:
+		s := fmt.Sprintf(">%d/%ds", a.Threshold, a.Window)
+		if a.MaxSources != 0 {
+			s += fmt.Sprintf(" max-sources %d", a.MaxSources)
+		}
+		return s
 	case *api.CommunityAction:
 		l := regexpCommunityString.ReplaceAllString(strings.Join(a.Communities, ", "), "")
 		var typ string
@@ -87,6 +93,8 @@ func prettyString(v interface{}) string {
 			typ = "replace"
 		}
 		return fmt.Sprintf("%s[%s]", typ, l)
+	case *api.AspaValidationCommunityAction:
+		return fmt.Sprintf("valid[%s] invalid[%s] unknown[%s]", a.Valid, a.Invalid, a.Unknown)
 	case *api.MedAction:
 		if a.Type == api.MedAction_MOD && a.Value > 0 {
 			return fmt.Sprintf("+%d", a.Value)
@@ -471,6 +479,9 @@ func printStatement(indent int, s *api.Statement) {
 	if c.AfiSafiIn != nil {
 		fmt.Printf("%sAFI SAFI In: %s\n", ind, c.AfiSafiIn)
 	}
+	if c.SourceRate != nil {
+		fmt.Printf("%sSourceRate: %s\n", ind, prettyString(c.SourceRate))
+	}
 
 	fmt.Printf("%sActions:\n", sIndent(indent+2))
 	a := s.Actions
@@ -483,6 +494,9 @@ func printStatement(indent int, s *api.Statement) {
 	if a.LargeCommunity != nil {
 		fmt.Println(ind, "LargeCommunity: ", prettyString(a.LargeCommunity))
 	}
+	if a.AspaValidationCommunity != nil {
+		fmt.Println(ind, "AspaValidationCommunity: ", prettyString(a.AspaValidationCommunity))
+	}
 	if a.Med != nil {
 		fmt.Println(ind, "MED: ", prettyString(a.Med))
 	}
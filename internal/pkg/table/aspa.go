@@ -0,0 +1,28 @@
+// Copyright (C) 2024 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// AspaVerifier verifies an AS_PATH against the ASPA (Autonomous System
+// Provider Authorization) records known to the engine. aspath is nil when
+// the path has no AS_PATH attribute.
+type AspaVerifier interface {
+	Verify(aspath *bgp.PathAttributeAsPath) oc.AspaValidationResultType
+}
@@ -18,6 +18,7 @@ package table
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"net"
 	"reflect"
 	"regexp"
@@ -25,6 +26,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/k-sone/critbitgo"
 	api "github.com/osrg/gobgp/v3/api"
@@ -37,6 +39,7 @@ type PolicyOptions struct {
 	Info       *PeerInfo
 	OldNextHop net.IP
 	Validate   func(*Path) *Validation
+	Aspa       AspaVerifier
 }
 
 type DefinedType int
@@ -155,6 +158,7 @@ const (
 	CONDITION_NEXT_HOP
 	CONDITION_AFI_SAFI_IN
 	CONDITION_COMMUNITY_COUNT
+	CONDITION_SOURCE_RATE
 )
 
 type ActionType int
@@ -168,8 +172,65 @@ const (
 	ACTION_NEXTHOP
 	ACTION_LOCAL_PREF
 	ACTION_LARGE_COMMUNITY
+	ACTION_ASPA_COMMUNITY
 )
 
+func (t ConditionType) String() string {
+	switch t {
+	case CONDITION_PREFIX:
+		return "prefix"
+	case CONDITION_NEIGHBOR:
+		return "neighbor"
+	case CONDITION_AS_PATH:
+		return "as-path"
+	case CONDITION_COMMUNITY:
+		return "community"
+	case CONDITION_EXT_COMMUNITY:
+		return "ext-community"
+	case CONDITION_AS_PATH_LENGTH:
+		return "as-path-length"
+	case CONDITION_RPKI:
+		return "rpki"
+	case CONDITION_ROUTE_TYPE:
+		return "route-type"
+	case CONDITION_LARGE_COMMUNITY:
+		return "large-community"
+	case CONDITION_NEXT_HOP:
+		return "next-hop"
+	case CONDITION_AFI_SAFI_IN:
+		return "afi-safi-in"
+	case CONDITION_COMMUNITY_COUNT:
+		return "community-count"
+	case CONDITION_SOURCE_RATE:
+		return "source-rate"
+	}
+	return fmt.Sprintf("unknown(%d)", t)
+}
+
+func (t ActionType) String() string {
+	switch t {
+	case ACTION_ROUTING:
+		return "routing"
+	case ACTION_COMMUNITY:
+		return "community"
+	case ACTION_EXT_COMMUNITY:
+		return "ext-community"
+	case ACTION_MED:
+		return "med"
+	case ACTION_AS_PATH_PREPEND:
+		return "as-path-prepend"
+	case ACTION_NEXTHOP:
+		return "next-hop"
+	case ACTION_LOCAL_PREF:
+		return "local-pref"
+	case ACTION_LARGE_COMMUNITY:
+		return "large-community"
+	case ACTION_ASPA_COMMUNITY:
+		return "aspa-community"
+	}
+	return fmt.Sprintf("unknown(%d)", t)
+}
+
 func NewMatchOption(c interface{}) (MatchOption, error) {
 	switch t := c.(type) {
 	case oc.MatchSetOptionsType:
@@ -1316,7 +1377,14 @@ var _regexpCommunityLarge = regexp.MustCompile(`\d+:\d+:\d+`)
 
 func ParseLargeCommunityRegexp(arg string) (*regexp.Regexp, error) {
 	if _regexpCommunityLarge.MatchString(arg) {
-		return regexp.Compile(fmt.Sprintf("^%s$", arg))
+		// anchor both ends, keeping the anchors already given
+		if !strings.HasPrefix(arg, "^") {
+			arg = "^" + arg
+		}
+		if !strings.HasSuffix(arg, "$") {
+			arg = arg + "$"
+		}
+		return regexp.Compile(arg)
 	}
 	exp, err := regexp.Compile(arg)
 	if err != nil {
@@ -1408,6 +1476,10 @@ func (c *NextHopCondition) Evaluate(path *Path, options *PolicyOptions) bool {
 	return false
 }
 
+func (c *NextHopCondition) ToConfig() []string {
+	return c.set.List()
+}
+
 func NewNextHopCondition(c []string) (*NextHopCondition, error) {
 	if len(c) == 0 {
 		return nil, nil
@@ -1476,6 +1548,13 @@ func (c *PrefixCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
 
 func (c *PrefixCondition) Name() string { return c.set.name }
 
+func (c *PrefixCondition) ToConfig() *oc.MatchPrefixSet {
+	return &oc.MatchPrefixSet{
+		PrefixSet:       c.set.Name(),
+		MatchSetOptions: c.option.ConvertToMatchSetOptionsRestrictedType(),
+	}
+}
+
 func NewPrefixCondition(c oc.MatchPrefixSet) (*PrefixCondition, error) {
 	if c.PrefixSet == "" {
 		return nil, nil
@@ -1542,6 +1621,13 @@ func (c *NeighborCondition) Evaluate(path *Path, options *PolicyOptions) bool {
 
 func (c *NeighborCondition) Name() string { return c.set.name }
 
+func (c *NeighborCondition) ToConfig() *oc.MatchNeighborSet {
+	return &oc.MatchNeighborSet{
+		NeighborSet:     c.set.Name(),
+		MatchSetOptions: c.option.ConvertToMatchSetOptionsRestrictedType(),
+	}
+}
+
 func NewNeighborCondition(c oc.MatchNeighborSet) (*NeighborCondition, error) {
 	if c.NeighborSet == "" {
 		return nil, nil
@@ -1614,6 +1700,13 @@ func (c *AsPathCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
 
 func (c *AsPathCondition) Name() string { return c.set.name }
 
+func (c *AsPathCondition) ToConfig() *oc.MatchAsPathSet {
+	return &oc.MatchAsPathSet{
+		AsPathSet:       c.set.Name(),
+		MatchSetOptions: oc.IntToMatchSetOptionsTypeMap[int(c.option)],
+	}
+}
+
 func NewAsPathCondition(c oc.MatchAsPathSet) (*AsPathCondition, error) {
 	if c.AsPathSet == "" {
 		return nil, nil
@@ -1673,6 +1766,13 @@ func (c *CommunityCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
 
 func (c *CommunityCondition) Name() string { return c.set.name }
 
+func (c *CommunityCondition) ToConfig() *oc.MatchCommunitySet {
+	return &oc.MatchCommunitySet{
+		CommunitySet:    c.set.Name(),
+		MatchSetOptions: oc.IntToMatchSetOptionsTypeMap[int(c.option)],
+	}
+}
+
 func NewCommunityCondition(c oc.MatchCommunitySet) (*CommunityCondition, error) {
 	if c.CommunitySet == "" {
 		return nil, nil
@@ -1747,6 +1847,13 @@ func (c *ExtCommunityCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
 
 func (c *ExtCommunityCondition) Name() string { return c.set.name }
 
+func (c *ExtCommunityCondition) ToConfig() *oc.MatchExtCommunitySet {
+	return &oc.MatchExtCommunitySet{
+		ExtCommunitySet: c.set.Name(),
+		MatchSetOptions: oc.IntToMatchSetOptionsTypeMap[int(c.option)],
+	}
+}
+
 func NewExtCommunityCondition(c oc.MatchExtCommunitySet) (*ExtCommunityCondition, error) {
 	if c.ExtCommunitySet == "" {
 		return nil, nil
@@ -1808,6 +1915,13 @@ func (c *LargeCommunityCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
 
 func (c *LargeCommunityCondition) Name() string { return c.set.name }
 
+func (c *LargeCommunityCondition) ToConfig() *oc.MatchLargeCommunitySet {
+	return &oc.MatchLargeCommunitySet{
+		LargeCommunitySet: c.set.Name(),
+		MatchSetOptions:   oc.IntToMatchSetOptionsTypeMap[int(c.option)],
+	}
+}
+
 func NewLargeCommunityCondition(c oc.MatchLargeCommunitySet) (*LargeCommunityCondition, error) {
 	if c.LargeCommunitySet == "" {
 		return nil, nil
@@ -1861,6 +1975,13 @@ func (c *CommunityCountCondition) String() string {
 	return fmt.Sprintf("%s%d", c.operator, c.count)
 }
 
+func (c *CommunityCountCondition) ToConfig() *oc.CommunityCount {
+	return &oc.CommunityCount{
+		Operator: oc.IntToAttributeComparisonMap[int(c.operator)],
+		Value:    c.count,
+	}
+}
+
 func NewCommunityCountCondition(c oc.CommunityCount) (*CommunityCountCondition, error) {
 	if c.Value == 0 && c.Operator == "" {
 		return nil, nil
@@ -1914,6 +2035,13 @@ func (c *AsPathLengthCondition) String() string {
 	return fmt.Sprintf("%s%d", c.operator, c.length)
 }
 
+func (c *AsPathLengthCondition) ToConfig() *oc.AsPathLength {
+	return &oc.AsPathLength{
+		Operator: oc.IntToAttributeComparisonMap[int(c.operator)],
+		Value:    c.length,
+	}
+}
+
 func NewAsPathLengthCondition(c oc.AsPathLength) (*AsPathLengthCondition, error) {
 	if c.Value == 0 && c.Operator == "" {
 		return nil, nil
@@ -1957,6 +2085,10 @@ func (c *RpkiValidationCondition) String() string {
 	return string(c.result)
 }
 
+func (c *RpkiValidationCondition) ToConfig() oc.RpkiValidationResultType {
+	return c.result
+}
+
 func NewRpkiValidationCondition(c oc.RpkiValidationResultType) (*RpkiValidationCondition, error) {
 	if c == oc.RpkiValidationResultType("") || c == oc.RPKI_VALIDATION_RESULT_TYPE_NONE {
 		return nil, nil
@@ -1996,6 +2128,10 @@ func (c *RouteTypeCondition) String() string {
 	return string(c.typ)
 }
 
+func (c *RouteTypeCondition) ToConfig() oc.RouteType {
+	return c.typ
+}
+
 func NewRouteTypeCondition(c oc.RouteType) (*RouteTypeCondition, error) {
 	if string(c) == "" || c == oc.ROUTE_TYPE_NONE {
 		return nil, nil
@@ -2039,6 +2175,14 @@ func (c *AfiSafiInCondition) String() string {
 	return strings.Join(tmp, " ")
 }
 
+func (c *AfiSafiInCondition) ToConfig() []oc.AfiSafiType {
+	res := make([]oc.AfiSafiType, 0, len(c.routeFamilies))
+	for _, rf := range c.routeFamilies {
+		res = append(res, oc.AfiSafiType(rf.String()))
+	}
+	return res
+}
+
 func NewAfiSafiInCondition(afiSafInConfig []oc.AfiSafiType) (*AfiSafiInCondition, error) {
 	if afiSafInConfig == nil {
 		return nil, nil
@@ -2060,6 +2204,174 @@ func NewAfiSafiInCondition(afiSafInConfig []oc.AfiSafiType) (*AfiSafiInCondition
 	}, nil
 }
 
+const (
+	DEFAULT_SOURCE_RATE_MAX_SOURCES = 1024
+	SOURCE_RATE_BUCKETS             = 10
+)
+
+// sourceRateCounter counts the updates of a single source in
+// SOURCE_RATE_BUCKETS consecutive sub-windows. Each bucket remembers which
+// sub-window it is counting so that stale buckets are ignored and reused
+// without having to be cleared.
+type sourceRateCounter struct {
+	counts [SOURCE_RATE_BUCKETS]uint32
+	slots  [SOURCE_RATE_BUCKETS]int64
+	last   int64
+}
+
+func (c *sourceRateCounter) add(slot int64) {
+	i := slot % SOURCE_RATE_BUCKETS
+	if c.slots[i] != slot {
+		c.slots[i] = slot
+		c.counts[i] = 0
+	}
+	if c.counts[i] < math.MaxUint32 {
+		c.counts[i]++
+	}
+	c.last = slot
+}
+
+func (c *sourceRateCounter) sum(slot int64) uint64 {
+	var n uint64
+	for i, s := range c.slots {
+		if s <= slot && s > slot-SOURCE_RATE_BUCKETS {
+			n += uint64(c.counts[i])
+		}
+	}
+	return n
+}
+
+// SourceRateCondition matches paths from a source which has sent more than
+// threshold UPDATE messages within the sliding window. The updates are
+// recorded by RoutingPolicy.RecordUpdate as they are received, so
+// evaluating the condition, for instance during a soft reset, does not
+// change its result.
+//
+// The window is split into SOURCE_RATE_BUCKETS sub-windows and the oldest
+// one is dropped as a whole, so the memory per source is constant whatever
+// the threshold. The buckets of a source idle for the whole window count as
+// zero, but its entry is only removed when a new source arrives while
+// maxSources sources are already tracked: the idle ones are dropped first,
+// otherwise the least recently updated one. The counters are lost when the
+// policy is reloaded.
+type SourceRateCondition struct {
+	threshold  uint32
+	window     time.Duration
+	maxSources uint32
+	now        func() time.Time
+	mu         sync.Mutex
+	sources    map[string]*sourceRateCounter
+}
+
+func (c *SourceRateCondition) Type() ConditionType {
+	return CONDITION_SOURCE_RATE
+}
+
+func (c *SourceRateCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
+	if path.IsLocal() {
+		return false
+	}
+	key := path.GetSource().Address.String()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	counter, ok := c.sources[key]
+	if !ok {
+		return false
+	}
+	return counter.sum(c.slot()) > uint64(c.threshold)
+}
+
+// record counts an UPDATE message received from source.
+func (c *SourceRateCondition) record(source *PeerInfo) {
+	key := source.Address.String()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	slot := c.slot()
+	counter, ok := c.sources[key]
+	if !ok {
+		if uint32(len(c.sources)) >= c.limit() {
+			c.evict(slot)
+		}
+		counter = &sourceRateCounter{}
+		for i := range counter.slots {
+			counter.slots[i] = math.MinInt64
+		}
+		c.sources[key] = counter
+	}
+	counter.add(slot)
+}
+
+func (c *SourceRateCondition) slot() int64 {
+	return c.now().UnixNano() / int64(c.window/SOURCE_RATE_BUCKETS)
+}
+
+func (c *SourceRateCondition) limit() uint32 {
+	if c.maxSources == 0 {
+		return DEFAULT_SOURCE_RATE_MAX_SOURCES
+	}
+	return c.maxSources
+}
+
+// evict drops every source idle for the whole window. If none of them is,
+// the least recently updated source is dropped instead.
+func (c *SourceRateCondition) evict(slot int64) {
+	var oldest string
+	var latest int64
+	for key, counter := range c.sources {
+		if counter.last <= slot-SOURCE_RATE_BUCKETS {
+			delete(c.sources, key)
+			continue
+		}
+		if oldest == "" || counter.last < latest {
+			oldest, latest = key, counter.last
+		}
+	}
+	if uint32(len(c.sources)) >= c.limit() {
+		delete(c.sources, oldest)
+	}
+}
+
+func (c *SourceRateCondition) Set() DefinedSet {
+	return nil
+}
+
+func (c *SourceRateCondition) Name() string { return "" }
+
+func (c *SourceRateCondition) String() string {
+	return fmt.Sprintf(">%d/%s", c.threshold, c.window)
+}
+
+func (c *SourceRateCondition) ToConfig() *oc.SourceRate {
+	return &oc.SourceRate{
+		Threshold:  c.threshold,
+		Window:     uint32(c.window / time.Second),
+		MaxSources: c.maxSources,
+	}
+}
+
+func NewSourceRateCondition(c oc.SourceRate) (*SourceRateCondition, error) {
+	if c.Threshold == 0 && c.Window == 0 && c.MaxSources == 0 {
+		return nil, nil
+	}
+	if c.Window == 0 {
+		return nil, fmt.Errorf("source rate window must be positive")
+	}
+	if c.Threshold == 0 {
+		return nil, fmt.Errorf("source rate threshold must be positive")
+	}
+	return &SourceRateCondition{
+		threshold:  c.Threshold,
+		window:     time.Duration(c.Window) * time.Second,
+		maxSources: c.MaxSources,
+		now:        time.Now,
+		sources:    make(map[string]*sourceRateCounter),
+	}, nil
+}
+
 type Action interface {
 	Type() ActionType
 	Apply(*Path, *PolicyOptions) (*Path, error)
@@ -2081,6 +2393,13 @@ func (a *RoutingAction) Apply(path *Path, _ *PolicyOptions) (*Path, error) {
 	return nil, nil
 }
 
+func (a *RoutingAction) ToConfig() oc.RouteDisposition {
+	if a.AcceptRoute {
+		return oc.ROUTE_DISPOSITION_ACCEPT_ROUTE
+	}
+	return oc.ROUTE_DISPOSITION_REJECT_ROUTE
+}
+
 func (a *RoutingAction) String() string {
 	action := "reject"
 	if a.AcceptRoute {
@@ -2469,7 +2788,7 @@ func (a *MedAction) Apply(path *Path, _ *PolicyOptions) (*Path, error) {
 }
 
 func (a *MedAction) ToConfig() oc.BgpSetMedType {
-	if a.action == MED_ACTION_MOD && a.value > 0 {
+	if a.action == MED_ACTION_MOD && a.value >= 0 {
 		return oc.BgpSetMedType(fmt.Sprintf("+%d", a.value))
 	}
 	return oc.BgpSetMedType(fmt.Sprintf("%d", a.value))
@@ -2687,6 +3006,84 @@ func NewNexthopAction(c oc.BgpNextHopType) (*NexthopAction, error) {
 	}, nil
 }
 
+// AspaCommunityAction tags a path with the community configured for the
+// outcome of ASPA verification of its AS_PATH. Communities configured for the
+// other outcomes are removed so that re-evaluation never leaves stale tags.
+type AspaCommunityAction struct {
+	communities map[oc.AspaValidationResultType]uint32
+	config      oc.SetAspaValidationCommunity
+}
+
+func (a *AspaCommunityAction) Type() ActionType {
+	return ACTION_ASPA_COMMUNITY
+}
+
+func (a *AspaCommunityAction) Apply(path *Path, options *PolicyOptions) (*Path, error) {
+	if options == nil || options.Aspa == nil {
+		return path, nil
+	}
+	result := options.Aspa.Verify(path.GetAsPath())
+	list := make([]uint32, 0, len(path.GetCommunities())+1)
+	for _, comm := range path.GetCommunities() {
+		stale := false
+		for _, c := range a.communities {
+			if comm == c {
+				stale = true
+				break
+			}
+		}
+		if !stale {
+			list = append(list, comm)
+		}
+	}
+	if comm, ok := a.communities[result]; ok {
+		list = append(list, comm)
+	}
+	path.SetCommunities(list, true)
+	return path, nil
+}
+
+func (a *AspaCommunityAction) ToConfig() *oc.SetAspaValidationCommunity {
+	c := a.config
+	return &c
+}
+
+func (a *AspaCommunityAction) String() string {
+	return fmt.Sprintf("valid[%s] invalid[%s] unknown[%s]", a.config.Valid, a.config.Invalid, a.config.Unknown)
+}
+
+func (a *AspaCommunityAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.ToConfig())
+}
+
+func NewAspaCommunityAction(c oc.SetAspaValidationCommunity) (*AspaCommunityAction, error) {
+	communities := make(map[oc.AspaValidationResultType]uint32)
+	for _, x := range []struct {
+		result oc.AspaValidationResultType
+		value  string
+	}{
+		{oc.ASPA_VALIDATION_RESULT_TYPE_VALID, c.Valid},
+		{oc.ASPA_VALIDATION_RESULT_TYPE_INVALID, c.Invalid},
+		{oc.ASPA_VALIDATION_RESULT_TYPE_UNKNOWN, c.Unknown},
+	} {
+		if x.value == "" {
+			continue
+		}
+		comm, err := ParseCommunity(x.value)
+		if err != nil {
+			return nil, err
+		}
+		communities[x.result] = comm
+	}
+	if len(communities) == 0 {
+		return nil, nil
+	}
+	return &AspaCommunityAction{
+		communities: communities,
+		config:      c,
+	}, nil
+}
+
 type Statement struct {
 	Name        string
 	Conditions  []Condition
@@ -2696,8 +3093,32 @@ type Statement struct {
 
 // evaluate each condition in the statement according to MatchSetOptions
 func (s *Statement) Evaluate(p *Path, options *PolicyOptions) bool {
-	for _, c := range s.Conditions {
-		if !c.Evaluate(p, options) {
+	return s.evaluate(p, options, nil)
+}
+
+// evaluate stops at the first condition which does not match. When e is not
+// nil, the result of every condition is recorded in it, and the conditions
+// left unevaluated are recorded as skipped.
+func (s *Statement) evaluate(p *Path, options *PolicyOptions, e *StatementExplanation) bool {
+	for i, c := range s.Conditions {
+		result := c.Evaluate(p, options)
+		if e != nil {
+			e.Conditions = append(e.Conditions, ConditionExplanation{
+				Type:   c.Type().String(),
+				Name:   c.Name(),
+				Result: result,
+			})
+		}
+		if !result {
+			if e != nil {
+				for _, c := range s.Conditions[i+1:] {
+					e.Conditions = append(e.Conditions, ConditionExplanation{
+						Type:    c.Type().String(),
+						Name:    c.Name(),
+						Skipped: true,
+					})
+				}
+			}
 			return false
 		}
 	}
@@ -2705,12 +3126,27 @@ func (s *Statement) Evaluate(p *Path, options *PolicyOptions) bool {
 }
 
 func (s *Statement) Apply(logger log.Logger, path *Path, options *PolicyOptions) (RouteType, *Path) {
-	result := s.Evaluate(path, options)
+	return s.apply(logger, path, options, nil)
+}
+
+// apply records the evaluated conditions and a snapshot of the path
+// attributes around every modification action in e when it is not nil.
+func (s *Statement) apply(logger log.Logger, path *Path, options *PolicyOptions, e *StatementExplanation) (RouteType, *Path) {
+	result := s.evaluate(path, options, e)
+	if e != nil {
+		e.Matched = result
+	}
 	if result {
 		if len(s.ModActions) != 0 {
 			// apply all modification actions
 			path = path.Clone(path.IsWithdraw)
 			for _, action := range s.ModActions {
+				var a ActionExplanation
+				if e != nil {
+					a.Type = action.Type().String()
+					a.Value = action.String()
+					a.Before, _ = json.Marshal(path.GetPathAttrs())
+				}
 				var err error
 				path, err = action.Apply(path, options)
 				if err != nil {
@@ -2718,6 +3154,11 @@ func (s *Statement) Apply(logger log.Logger, path *Path, options *PolicyOptions)
 						log.Fields{
 							"Topic": "policy",
 							"Error": err})
+					a.Error = err.Error()
+				}
+				if e != nil {
+					a.After, _ = json.Marshal(path.GetPathAttrs())
+					e.Actions = append(e.Actions, a)
 				}
 			}
 		}
@@ -2742,33 +3183,31 @@ func (s *Statement) ToConfig() *oc.Statement {
 			for _, c := range s.Conditions {
 				switch v := c.(type) {
 				case *PrefixCondition:
-					cond.MatchPrefixSet = oc.MatchPrefixSet{PrefixSet: v.set.Name(), MatchSetOptions: v.option.ConvertToMatchSetOptionsRestrictedType()}
+					cond.MatchPrefixSet = *v.ToConfig()
 				case *NeighborCondition:
-					cond.MatchNeighborSet = oc.MatchNeighborSet{NeighborSet: v.set.Name(), MatchSetOptions: v.option.ConvertToMatchSetOptionsRestrictedType()}
+					cond.MatchNeighborSet = *v.ToConfig()
 				case *CommunityCountCondition:
-					cond.BgpConditions.CommunityCount = oc.CommunityCount{Operator: oc.IntToAttributeComparisonMap[int(v.operator)], Value: v.count}
+					cond.BgpConditions.CommunityCount = *v.ToConfig()
 				case *AsPathLengthCondition:
-					cond.BgpConditions.AsPathLength = oc.AsPathLength{Operator: oc.IntToAttributeComparisonMap[int(v.operator)], Value: v.length}
+					cond.BgpConditions.AsPathLength = *v.ToConfig()
 				case *AsPathCondition:
-					cond.BgpConditions.MatchAsPathSet = oc.MatchAsPathSet{AsPathSet: v.set.Name(), MatchSetOptions: oc.IntToMatchSetOptionsTypeMap[int(v.option)]}
+					cond.BgpConditions.MatchAsPathSet = *v.ToConfig()
 				case *CommunityCondition:
-					cond.BgpConditions.MatchCommunitySet = oc.MatchCommunitySet{CommunitySet: v.set.Name(), MatchSetOptions: oc.IntToMatchSetOptionsTypeMap[int(v.option)]}
+					cond.BgpConditions.MatchCommunitySet = *v.ToConfig()
 				case *ExtCommunityCondition:
-					cond.BgpConditions.MatchExtCommunitySet = oc.MatchExtCommunitySet{ExtCommunitySet: v.set.Name(), MatchSetOptions: oc.IntToMatchSetOptionsTypeMap[int(v.option)]}
+					cond.BgpConditions.MatchExtCommunitySet = *v.ToConfig()
 				case *LargeCommunityCondition:
-					cond.BgpConditions.MatchLargeCommunitySet = oc.MatchLargeCommunitySet{LargeCommunitySet: v.set.Name(), MatchSetOptions: oc.IntToMatchSetOptionsTypeMap[int(v.option)]}
+					cond.BgpConditions.MatchLargeCommunitySet = *v.ToConfig()
 				case *NextHopCondition:
-					cond.BgpConditions.NextHopInList = v.set.List()
+					cond.BgpConditions.NextHopInList = v.ToConfig()
 				case *RpkiValidationCondition:
-					cond.BgpConditions.RpkiValidationResult = v.result
+					cond.BgpConditions.RpkiValidationResult = v.ToConfig()
 				case *RouteTypeCondition:
-					cond.BgpConditions.RouteType = v.typ
+					cond.BgpConditions.RouteType = v.ToConfig()
+				case *SourceRateCondition:
+					cond.BgpConditions.SourceRate = *v.ToConfig()
 				case *AfiSafiInCondition:
-					res := make([]oc.AfiSafiType, 0, len(v.routeFamilies))
-					for _, rf := range v.routeFamilies {
-						res = append(res, oc.AfiSafiType(rf.String()))
-					}
-					cond.BgpConditions.AfiSafiInList = res
+					cond.BgpConditions.AfiSafiInList = v.ToConfig()
 				}
 			}
 			return cond
@@ -2776,12 +3215,7 @@ func (s *Statement) ToConfig() *oc.Statement {
 		Actions: func() oc.Actions {
 			act := oc.Actions{}
 			if s.RouteAction != nil && !reflect.ValueOf(s.RouteAction).IsNil() {
-				a := s.RouteAction.(*RoutingAction)
-				if a.AcceptRoute {
-					act.RouteDisposition = oc.ROUTE_DISPOSITION_ACCEPT_ROUTE
-				} else {
-					act.RouteDisposition = oc.ROUTE_DISPOSITION_REJECT_ROUTE
-				}
+				act.RouteDisposition = s.RouteAction.(*RoutingAction).ToConfig()
 			} else {
 				act.RouteDisposition = oc.ROUTE_DISPOSITION_NONE
 			}
@@ -2801,6 +3235,8 @@ func (s *Statement) ToConfig() *oc.Statement {
 					act.BgpActions.SetLocalPref = v.ToConfig()
 				case *NexthopAction:
 					act.BgpActions.SetNextHop = v.ToConfig()
+				case *AspaCommunityAction:
+					act.BgpActions.SetAspaValidationCommunity = *v.ToConfig()
 				}
 			}
 			return act
@@ -2976,6 +3412,9 @@ func NewStatement(c oc.Statement) (*Statement, error) {
 		func() (Condition, error) {
 			return NewAfiSafiInCondition(c.Conditions.BgpConditions.AfiSafiInList)
 		},
+		func() (Condition, error) {
+			return NewSourceRateCondition(c.Conditions.BgpConditions.SourceRate)
+		},
 	}
 	cs = make([]Condition, 0, len(cfs))
 	for _, f := range cfs {
@@ -3013,6 +3452,9 @@ func NewStatement(c oc.Statement) (*Statement, error) {
 		func() (Action, error) {
 			return NewNexthopAction(c.Actions.BgpActions.SetNextHop)
 		},
+		func() (Action, error) {
+			return NewAspaCommunityAction(c.Actions.BgpActions.SetAspaValidationCommunity)
+		},
 	}
 	as = make([]Action, 0, len(afs))
 	for _, f := range afs {
@@ -3041,14 +3483,32 @@ type Policy struct {
 // If a condition match, then this function stops evaluation and
 // subsequent conditions are skipped.
 func (p *Policy) Apply(logger log.Logger, path *Path, options *PolicyOptions) (RouteType, *Path) {
+	return p.apply(logger, path, options, nil)
+}
+
+func (p *Policy) apply(logger log.Logger, path *Path, options *PolicyOptions, e *PolicyExplanation) (RouteType, *Path) {
+	result := ROUTE_TYPE_NONE
 	for _, stmt := range p.Statements {
-		var result RouteType
-		result, path = stmt.Apply(logger, path, options)
+		var se *StatementExplanation
+		if e != nil {
+			se = &StatementExplanation{
+				Name:       stmt.Name,
+				Conditions: make([]ConditionExplanation, 0, len(stmt.Conditions)),
+			}
+		}
+		result, path = stmt.apply(logger, path, options, se)
+		if e != nil {
+			se.Result = result.String()
+			e.Statements = append(e.Statements, *se)
+		}
 		if result != ROUTE_TYPE_NONE {
-			return result, path
+			break
 		}
 	}
-	return ROUTE_TYPE_NONE, path
+	if e != nil {
+		e.Result = result.String()
+	}
+	return result, path
 }
 
 func (p *Policy) ToConfig() *oc.PolicyDefinition {
@@ -3158,6 +3618,7 @@ type RoutingPolicy struct {
 	policyMap     map[string]*Policy
 	statementMap  map[string]*Statement
 	assignmentMap map[string]*Assignment
+	sourceRates   []*SourceRateCondition
 	mu            sync.RWMutex
 	logger        log.Logger
 }
@@ -3166,7 +3627,10 @@ func (r *RoutingPolicy) ApplyPolicy(id string, dir PolicyDirection, before *Path
 	if before == nil {
 		return nil
 	}
+	return r.applyPolicy(id, dir, before, options, nil)
+}
 
+func (r *RoutingPolicy) applyPolicy(id string, dir PolicyDirection, before *Path, options *PolicyOptions, e *PathExplanation) *Path {
 	if before.IsWithdraw {
 		return before
 	}
@@ -3177,12 +3641,25 @@ func (r *RoutingPolicy) ApplyPolicy(id string, dir PolicyDirection, before *Path
 	defer r.mu.RUnlock()
 
 	for _, p := range r.getPolicy(id, dir) {
-		result, after = p.Apply(r.logger, after, options)
+		var pe *PolicyExplanation
+		if e != nil {
+			pe = &PolicyExplanation{
+				Name:       p.Name,
+				Statements: make([]StatementExplanation, 0, len(p.Statements)),
+			}
+		}
+		result, after = p.apply(r.logger, after, options, pe)
+		if e != nil {
+			e.Policies = append(e.Policies, *pe)
+		}
 		if result != ROUTE_TYPE_NONE {
 			break
 		}
 	}
 	if result == ROUTE_TYPE_NONE {
+		if e != nil {
+			e.DefaultPolicy = true
+		}
 		result = r.getDefaultPolicy(id, dir)
 	}
 	switch result {
@@ -3193,6 +3670,102 @@ func (r *RoutingPolicy) ApplyPolicy(id string, dir PolicyDirection, before *Path
 	}
 }
 
+// ConditionExplanation is the result of a single condition evaluated by
+// ExplainPath. Skipped is set for the conditions following the first one
+// which did not match, since they are not evaluated.
+type ConditionExplanation struct {
+	Type    string `json:"type"`
+	Name    string `json:"name,omitempty"`
+	Result  bool   `json:"result"`
+	Skipped bool   `json:"skipped,omitempty"`
+}
+
+// ActionExplanation is a single modification action applied by ExplainPath.
+// Before and After hold the path attributes around the action.
+type ActionExplanation struct {
+	Type   string          `json:"type"`
+	Value  string          `json:"value"`
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+	Error  string          `json:"error,omitempty"`
+}
+
+type StatementExplanation struct {
+	Name       string                 `json:"name"`
+	Conditions []ConditionExplanation `json:"conditions"`
+	Matched    bool                   `json:"matched"`
+	Actions    []ActionExplanation    `json:"actions,omitempty"`
+	Result     string                 `json:"result"`
+}
+
+type PolicyExplanation struct {
+	Name       string                 `json:"name"`
+	Statements []StatementExplanation `json:"statements"`
+	Result     string                 `json:"result"`
+}
+
+// PathExplanation is the document returned by ExplainPath.
+type PathExplanation struct {
+	Id            string              `json:"id"`
+	Direction     string              `json:"direction"`
+	Policies      []PolicyExplanation `json:"policies"`
+	DefaultPolicy bool                `json:"default-policy"`
+	Decision      string              `json:"decision"`
+}
+
+// RecordUpdate counts an UPDATE message received from source in every
+// source rate condition. It is meant to be called once per received message,
+// not when paths are evaluated.
+func (r *RoutingPolicy) RecordUpdate(source *PeerInfo) {
+	if source == nil || source.Address == nil {
+		return
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, c := range r.sourceRates {
+		c.record(source)
+	}
+}
+
+// updateSourceRates collects the source rate conditions of every statement
+// so that RecordUpdate does not have to walk them. It must be called with
+// the write lock held whenever statements are added, modified or removed.
+func (r *RoutingPolicy) updateSourceRates() {
+	r.sourceRates = nil
+	for _, s := range r.statementMap {
+		for _, c := range s.Conditions {
+			if sc, ok := c.(*SourceRateCondition); ok {
+				r.sourceRates = append(r.sourceRates, sc)
+			}
+		}
+	}
+}
+
+// ExplainPath applies the policies assigned to id in direction dir like
+// ApplyPolicy does and returns the whole decision pipeline as JSON. The
+// given path is never modified. Withdrawals are rejected with an error since
+// ApplyPolicy accepts them without evaluating any policy.
+func (r *RoutingPolicy) ExplainPath(id string, dir PolicyDirection, path *Path, options *PolicyOptions) ([]byte, error) {
+	if path == nil {
+		return nil, fmt.Errorf("path is nil")
+	}
+	if path.IsWithdraw {
+		return nil, fmt.Errorf("withdrawn paths bypass policy evaluation")
+	}
+	e := PathExplanation{
+		Id:        id,
+		Direction: dir.String(),
+		Policies:  []PolicyExplanation{},
+	}
+	if r.applyPolicy(id, dir, path, options, &e) != nil {
+		e.Decision = ROUTE_TYPE_ACCEPT.String()
+	} else {
+		e.Decision = ROUTE_TYPE_REJECT.String()
+	}
+	return json.Marshal(e)
+}
+
 func (r *RoutingPolicy) getPolicy(id string, dir PolicyDirection) []*Policy {
 	a, ok := r.assignmentMap[id]
 	if !ok {
@@ -3487,6 +4060,7 @@ func (r *RoutingPolicy) reload(c oc.RoutingPolicy) error {
 	r.definedSetMap = dmap
 	r.policyMap = pmap
 	r.statementMap = smap
+	r.updateSourceRates()
 	r.assignmentMap = make(map[string]*Assignment)
 	// allow all routes coming in and going out by default
 	r.setDefaultPolicy(GLOBAL_RIB_NAME, POLICY_DIRECTION_IMPORT, ROUTE_TYPE_ACCEPT)
@@ -3494,28 +4068,7 @@ func (r *RoutingPolicy) reload(c oc.RoutingPolicy) error {
 	return nil
 }
 
-func (r *RoutingPolicy) GetDefinedSet(typ DefinedType, name string) (*oc.DefinedSets, error) {
-	dl, err := func() (DefinedSetList, error) {
-		r.mu.RLock()
-		defer r.mu.RUnlock()
-
-		set, ok := r.definedSetMap[typ]
-		if !ok {
-			return nil, fmt.Errorf("invalid defined-set type: %d", typ)
-		}
-
-		var dl DefinedSetList
-		for _, s := range set {
-			dl = append(dl, s)
-		}
-		return dl, nil
-	}()
-	if err != nil {
-		return nil, err
-	}
-
-	sort.Sort(dl)
-
+func (l DefinedSetList) ToConfig() *oc.DefinedSets {
 	sets := &oc.DefinedSets{
 		PrefixSets:   make([]oc.PrefixSet, 0),
 		NeighborSets: make([]oc.NeighborSet, 0),
@@ -3526,10 +4079,7 @@ func (r *RoutingPolicy) GetDefinedSet(typ DefinedType, name string) (*oc.Defined
 			AsPathSets:         make([]oc.AsPathSet, 0),
 		},
 	}
-	for _, s := range dl {
-		if name != "" && s.Name() != name {
-			continue
-		}
+	for _, s := range l {
 		switch v := s.(type) {
 		case *PrefixSet:
 			sets.PrefixSets = append(sets.PrefixSets, *v.ToConfig())
@@ -3545,7 +4095,35 @@ func (r *RoutingPolicy) GetDefinedSet(typ DefinedType, name string) (*oc.Defined
 			sets.BgpDefinedSets.AsPathSets = append(sets.BgpDefinedSets.AsPathSets, *v.ToConfig())
 		}
 	}
-	return sets, nil
+	return sets
+}
+
+func (r *RoutingPolicy) GetDefinedSet(typ DefinedType, name string) (*oc.DefinedSets, error) {
+	dl, err := func() (DefinedSetList, error) {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+
+		set, ok := r.definedSetMap[typ]
+		if !ok {
+			return nil, fmt.Errorf("invalid defined-set type: %d", typ)
+		}
+
+		var dl DefinedSetList
+		for _, s := range set {
+			if name != "" && s.Name() != name {
+				continue
+			}
+			dl = append(dl, s)
+		}
+		return dl, nil
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Sort(dl)
+
+	return dl.ToConfig(), nil
 }
 
 func (r *RoutingPolicy) AddDefinedSet(s DefinedSet, replace bool) error {
@@ -3607,6 +4185,7 @@ func (r *RoutingPolicy) GetStatement(name string) []*oc.Statement {
 func (r *RoutingPolicy) AddStatement(st *Statement) (err error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	defer r.updateSourceRates()
 
 	for _, c := range st.Conditions {
 		if err = r.validateCondition(c); err != nil {
@@ -3627,6 +4206,7 @@ func (r *RoutingPolicy) AddStatement(st *Statement) (err error) {
 func (r *RoutingPolicy) DeleteStatement(st *Statement, all bool) (err error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	defer r.updateSourceRates()
 
 	m := r.statementMap
 	name := st.Name
@@ -3670,9 +4250,49 @@ func (r *RoutingPolicy) GetPolicy(name string) []*oc.PolicyDefinition {
 	return l
 }
 
+// ToConfig reconstructs the defined sets and the policy definitions from the
+// live policy objects, including the ones added at runtime, so that they can
+// be written back to the configuration.
+//
+// The output is canonicalized rather than a copy of the original strings:
+// communities become anchored regular expressions ("100:100" becomes
+// "^100:100$", well-known names their numeric value), addresses become
+// prefixes ("10.0.0.1" becomes "10.0.0.1/32"), an empty mask length range
+// becomes the length of the prefix ("8..8" for "10.0.0.0/8") and empty
+// match set options are spelled out. Reloading the output compiles to the
+// same policies.
+func (r *RoutingPolicy) ToConfig() *oc.RoutingPolicy {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var dl DefinedSetList
+	for _, set := range r.definedSetMap {
+		for _, s := range set {
+			dl = append(dl, s)
+		}
+	}
+	sort.Sort(dl)
+
+	ps := make(Policies, 0, len(r.policyMap))
+	for _, p := range r.policyMap {
+		ps = append(ps, p)
+	}
+	sort.Sort(ps)
+
+	pds := make([]oc.PolicyDefinition, 0, len(ps))
+	for _, p := range ps {
+		pds = append(pds, *p.ToConfig())
+	}
+	return &oc.RoutingPolicy{
+		DefinedSets:       *dl.ToConfig(),
+		PolicyDefinitions: pds,
+	}
+}
+
 func (r *RoutingPolicy) AddPolicy(x *Policy, refer bool) (err error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	defer r.updateSourceRates()
 
 	for _, st := range x.Statements {
 		for _, c := range st.Conditions {
@@ -3709,6 +4329,7 @@ func (r *RoutingPolicy) AddPolicy(x *Policy, refer bool) (err error) {
 func (r *RoutingPolicy) DeletePolicy(x *Policy, all, preserve bool, activeId []string) (err error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	defer r.updateSourceRates()
 
 	pMap := r.policyMap
 	sMap := r.statementMap
@@ -4014,6 +4635,13 @@ func toStatementApi(s *oc.Statement) *api.Statement {
 			Type:   api.AsPathLength_Type(s.Conditions.BgpConditions.AsPathLength.Operator.ToInt()),
 		}
 	}
+	if r := s.Conditions.BgpConditions.SourceRate; r.Threshold != 0 || r.Window != 0 || r.MaxSources != 0 {
+		cs.SourceRate = &api.SourceRate{
+			Threshold:  r.Threshold,
+			Window:     r.Window,
+			MaxSources: r.MaxSources,
+		}
+	}
 	if s.Conditions.BgpConditions.MatchAsPathSet.AsPathSet != "" {
 		cs.AsPathSet = &api.MatchSet{
 			Type: api.MatchSet_Type(s.Conditions.BgpConditions.MatchAsPathSet.MatchSetOptions.ToInt()),
@@ -4131,6 +4759,17 @@ func toStatementApi(s *oc.Statement) *api.Statement {
 				Communities: s.Actions.BgpActions.SetLargeCommunity.SetLargeCommunityMethod.CommunitiesList,
 			}
 		}(),
+		AspaValidationCommunity: func() *api.AspaValidationCommunityAction {
+			c := s.Actions.BgpActions.SetAspaValidationCommunity
+			if c.Valid == "" && c.Invalid == "" && c.Unknown == "" {
+				return nil
+			}
+			return &api.AspaValidationCommunityAction{
+				Valid:   c.Valid,
+				Invalid: c.Invalid,
+				Unknown: c.Unknown,
+			}
+		}(),
 		Nexthop: func() *api.NexthopAction {
 			if len(string(s.Actions.BgpActions.SetNextHop)) == 0 {
 				return nil
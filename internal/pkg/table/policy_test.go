@@ -16,9 +16,11 @@
 package table
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"net"
+	"sort"
 	"strconv"
 	"strings"
 	"testing"
@@ -3010,6 +3012,24 @@ func TestParseCommunityRegexp(t *testing.T) {
 	assert.Equal(t, l6, r6.String())
 }
 
+func TestParseLargeCommunityRegexp(t *testing.T) {
+	for _, tt := range []struct {
+		arg  string
+		want string
+	}{
+		{"100:100:100", "^100:100:100$"},
+		{"^100:100:100$", "^100:100:100$"},
+		{"^1:2:3", "^1:2:3$"},
+		{"1:2:3$", "^1:2:3$"},
+		{"65000:1:2.*", "^65000:1:2.*$"},
+		{"^100:", "^100:"},
+	} {
+		exp, err := ParseLargeCommunityRegexp(tt.arg)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, exp.String())
+	}
+}
+
 func TestLocalPrefAction(t *testing.T) {
 	action, err := NewLocalPrefAction(10)
 	assert.Nil(t, err)
@@ -3512,3 +3532,584 @@ func TestNewSingleAsPathMatch(t *testing.T) {
 	r = NewSingleAsPathMatch("^65100$")
 	assert.Equal(t, r.mode, ONLY)
 }
+
+func TestExplainPath(t *testing.T) {
+	// create path
+	peer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.1")}
+	origin := bgp.NewPathAttributeOrigin(0)
+	aspathParam := []bgp.AsPathParamInterface{bgp.NewAsPathParam(2, []uint16{65001})}
+	aspath := bgp.NewPathAttributeAsPath(aspathParam)
+	nexthop := bgp.NewPathAttributeNextHop("10.0.0.1")
+	med := bgp.NewPathAttributeMultiExitDisc(100)
+
+	pathAttributes := []bgp.PathAttributeInterface{origin, aspath, nexthop, med}
+	nlri := []*bgp.IPAddrPrefix{bgp.NewIPAddrPrefix(24, "10.10.0.101")}
+	updateMsg := bgp.NewBGPUpdateMessage(nil, pathAttributes, nlri)
+	path := ProcessMessage(updateMsg, peer, time.Now())[0]
+
+	// create policy
+	ps := createPrefixSet("ps1", "10.10.0.0/16", "21..24")
+	ns := createNeighborSet("ns1", "10.0.0.1")
+
+	ds := oc.DefinedSets{}
+	ds.PrefixSets = []oc.PrefixSet{ps}
+	ds.NeighborSets = []oc.NeighborSet{ns}
+
+	s := createStatement("statement1", "ps1", "ns1", true)
+	s.Actions.BgpActions.SetMed = oc.BgpSetMedType("200")
+
+	pd := createPolicyDefinition("pd1", s)
+	pl := createRoutingPolicy(ds, pd)
+	r := NewRoutingPolicy(logger)
+	err := r.reload(pl)
+	require.NoError(t, err)
+	r.setPolicy("peer1", POLICY_DIRECTION_IMPORT, []*Policy{r.policyMap["pd1"]})
+
+	// test
+	b, err := r.ExplainPath("peer1", POLICY_DIRECTION_IMPORT, path, nil)
+	require.NoError(t, err)
+
+	var e struct {
+		Id            string `json:"id"`
+		Direction     string `json:"direction"`
+		DefaultPolicy bool   `json:"default-policy"`
+		Decision      string `json:"decision"`
+		Policies      []struct {
+			Name       string `json:"name"`
+			Result     string `json:"result"`
+			Statements []struct {
+				Name       string `json:"name"`
+				Matched    bool   `json:"matched"`
+				Result     string `json:"result"`
+				Conditions []struct {
+					Type    string `json:"type"`
+					Name    string `json:"name"`
+					Result  bool   `json:"result"`
+					Skipped bool   `json:"skipped"`
+				} `json:"conditions"`
+				Actions []struct {
+					Type   string                   `json:"type"`
+					Value  string                   `json:"value"`
+					Before []map[string]interface{} `json:"before"`
+					After  []map[string]interface{} `json:"after"`
+				} `json:"actions"`
+			} `json:"statements"`
+		} `json:"policies"`
+	}
+	require.NoError(t, json.Unmarshal(b, &e))
+
+	assert.Equal(t, "peer1", e.Id)
+	assert.Equal(t, "import", e.Direction)
+	assert.False(t, e.DefaultPolicy)
+	assert.Equal(t, "accept", e.Decision)
+
+	require.Len(t, e.Policies, 1)
+	assert.Equal(t, "pd1", e.Policies[0].Name)
+	assert.Equal(t, "accept", e.Policies[0].Result)
+
+	require.Len(t, e.Policies[0].Statements, 1)
+	st := e.Policies[0].Statements[0]
+	assert.Equal(t, "statement1", st.Name)
+	assert.True(t, st.Matched)
+	assert.Equal(t, "accept", st.Result)
+
+	require.Len(t, st.Conditions, 2)
+	assert.Equal(t, "prefix", st.Conditions[0].Type)
+	assert.Equal(t, "ps1", st.Conditions[0].Name)
+	assert.True(t, st.Conditions[0].Result)
+	assert.Equal(t, "neighbor", st.Conditions[1].Type)
+	assert.Equal(t, "ns1", st.Conditions[1].Name)
+	assert.True(t, st.Conditions[1].Result)
+
+	require.Len(t, st.Actions, 1)
+	assert.Equal(t, "med", st.Actions[0].Type)
+	assert.Equal(t, "200", st.Actions[0].Value)
+	metric := func(attrs []map[string]interface{}) interface{} {
+		for _, a := range attrs {
+			if a["type"] == float64(bgp.BGP_ATTR_TYPE_MULTI_EXIT_DISC) {
+				return a["metric"]
+			}
+		}
+		return nil
+	}
+	assert.Equal(t, float64(100), metric(st.Actions[0].Before))
+	assert.Equal(t, float64(200), metric(st.Actions[0].After))
+
+	// the original path must be left untouched
+	v, err := path.GetMed()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(100), v)
+
+	// the conditions following the first mismatch are not evaluated
+	nlri = []*bgp.IPAddrPrefix{bgp.NewIPAddrPrefix(24, "192.168.0.0")}
+	updateMsg = bgp.NewBGPUpdateMessage(nil, pathAttributes, nlri)
+	path = ProcessMessage(updateMsg, peer, time.Now())[0]
+	b, err = r.ExplainPath("peer1", POLICY_DIRECTION_IMPORT, path, nil)
+	require.NoError(t, err)
+	e.Policies = nil
+	require.NoError(t, json.Unmarshal(b, &e))
+
+	assert.True(t, e.DefaultPolicy)
+	require.Len(t, e.Policies, 1)
+	require.Len(t, e.Policies[0].Statements, 1)
+	st = e.Policies[0].Statements[0]
+	assert.False(t, st.Matched)
+	assert.Equal(t, "continue", st.Result)
+	assert.Empty(t, st.Actions)
+	require.Len(t, st.Conditions, 2)
+	assert.False(t, st.Conditions[0].Result)
+	assert.False(t, st.Conditions[0].Skipped)
+	assert.False(t, st.Conditions[1].Result)
+	assert.True(t, st.Conditions[1].Skipped)
+
+	// withdrawals are accepted without being evaluated, so there is nothing
+	// to explain
+	_, err = r.ExplainPath("peer1", POLICY_DIRECTION_IMPORT, path.Clone(true), nil)
+	assert.Error(t, err)
+}
+
+type mockAspaVerifier struct {
+	result oc.AspaValidationResultType
+	aspath *bgp.PathAttributeAsPath
+}
+
+func (v *mockAspaVerifier) Verify(aspath *bgp.PathAttributeAsPath) oc.AspaValidationResultType {
+	v.aspath = aspath
+	return v.result
+}
+
+func TestAspaCommunityAction(t *testing.T) {
+	action, err := NewAspaCommunityAction(oc.SetAspaValidationCommunity{
+		Valid:   "65000:1",
+		Invalid: "65000:2",
+		Unknown: "65000:3",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, action)
+
+	nlri := bgp.NewIPAddrPrefix(24, "10.0.0.0")
+	origin := bgp.NewPathAttributeOrigin(0)
+	aspath := bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{65001, 65002})})
+	nexthop := bgp.NewPathAttributeNextHop("10.0.0.1")
+	communities := bgp.NewPathAttributeCommunities([]uint32{stringToCommunityValue("100:100")})
+	attrs := []bgp.PathAttributeInterface{origin, aspath, nexthop, communities}
+
+	for _, tt := range []struct {
+		result oc.AspaValidationResultType
+		want   string
+	}{
+		{oc.ASPA_VALIDATION_RESULT_TYPE_VALID, "65000:1"},
+		{oc.ASPA_VALIDATION_RESULT_TYPE_INVALID, "65000:2"},
+		{oc.ASPA_VALIDATION_RESULT_TYPE_UNKNOWN, "65000:3"},
+	} {
+		t.Run(string(tt.result), func(t *testing.T) {
+			v := &mockAspaVerifier{result: tt.result}
+			path := NewPath(nil, nlri, false, attrs, time.Now(), false)
+			p, err := action.Apply(path.Clone(false), &PolicyOptions{Aspa: v})
+			require.NoError(t, err)
+			assert.Equal(t, aspath, v.aspath)
+			assert.Equal(t, []uint32{stringToCommunityValue("100:100"), stringToCommunityValue(tt.want)}, p.GetCommunities())
+
+			// a stale tag from a previous evaluation is replaced
+			v.result = oc.ASPA_VALIDATION_RESULT_TYPE_INVALID
+			p, err = action.Apply(p, &PolicyOptions{Aspa: v})
+			require.NoError(t, err)
+			assert.Equal(t, []uint32{stringToCommunityValue("100:100"), stringToCommunityValue("65000:2")}, p.GetCommunities())
+		})
+	}
+
+	// without a verifier the path is left untouched
+	path := NewPath(nil, nlri, false, attrs, time.Now(), false)
+	p, err := action.Apply(path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{stringToCommunityValue("100:100")}, p.GetCommunities())
+
+	// an outcome without a configured community only removes stale tags
+	action, err = NewAspaCommunityAction(oc.SetAspaValidationCommunity{
+		Invalid: "65000:2",
+	})
+	require.NoError(t, err)
+	path = NewPath(nil, nlri, false, []bgp.PathAttributeInterface{origin, aspath, nexthop, bgp.NewPathAttributeCommunities([]uint32{stringToCommunityValue("100:100"), stringToCommunityValue("65000:2")})}, time.Now(), false)
+	p, err = action.Apply(path, &PolicyOptions{Aspa: &mockAspaVerifier{result: oc.ASPA_VALIDATION_RESULT_TYPE_VALID}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{stringToCommunityValue("100:100")}, p.GetCommunities())
+
+	assert.Equal(t, &oc.SetAspaValidationCommunity{Invalid: "65000:2"}, action.ToConfig())
+
+	// the configured strings are kept as they are
+	c := oc.SetAspaValidationCommunity{Valid: "no-export", Invalid: "4259840001"}
+	action, err = NewAspaCommunityAction(c)
+	require.NoError(t, err)
+	assert.Equal(t, &c, action.ToConfig())
+	path = NewPath(nil, nlri, false, attrs, time.Now(), false)
+	p, err = action.Apply(path, &PolicyOptions{Aspa: &mockAspaVerifier{result: oc.ASPA_VALIDATION_RESULT_TYPE_INVALID}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{stringToCommunityValue("100:100"), 4259840001}, p.GetCommunities())
+	p, err = action.Apply(p, &PolicyOptions{Aspa: &mockAspaVerifier{result: oc.ASPA_VALIDATION_RESULT_TYPE_VALID}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{stringToCommunityValue("100:100"), uint32(bgp.COMMUNITY_NO_EXPORT)}, p.GetCommunities())
+
+	// the outcomes are parsed in a fixed order
+	for i := 0; i < 10; i++ {
+		_, err = NewAspaCommunityAction(oc.SetAspaValidationCommunity{Valid: "bad1", Invalid: "bad2", Unknown: "bad3"})
+		assert.EqualError(t, err, "failed to parse bad1 as community")
+	}
+
+	action, err = NewAspaCommunityAction(oc.SetAspaValidationCommunity{})
+	assert.NoError(t, err)
+	assert.Nil(t, action)
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestSourceRateCondition(t *testing.T) {
+	c, err := NewSourceRateCondition(oc.SourceRate{Threshold: 3, Window: 10})
+	require.NoError(t, err)
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	c.now = clock.Now
+
+	nlri := bgp.NewIPAddrPrefix(24, "10.0.0.0")
+	chattyPeer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.1")}
+	quietPeer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.2")}
+	chatty := NewPath(chattyPeer, nlri, false, []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}, time.Now(), false)
+	quiet := NewPath(quietPeer, nlri, false, []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}, time.Now(), false)
+
+	// a burst of updates within the window crosses the threshold
+	for i := 0; i < 3; i++ {
+		c.record(chattyPeer)
+		assert.False(t, c.Evaluate(chatty, nil))
+		clock.Advance(time.Second)
+	}
+	// evaluating the condition does not count as an update
+	for i := 0; i < 5; i++ {
+		assert.False(t, c.Evaluate(chatty, nil))
+	}
+	c.record(chattyPeer)
+	assert.True(t, c.Evaluate(chatty, nil))
+	// the counters are kept per source
+	assert.False(t, c.Evaluate(quiet, nil))
+	c.record(quietPeer)
+	assert.False(t, c.Evaluate(quiet, nil))
+
+	// the oldest updates slide out of the window
+	clock.Advance(7 * time.Second)
+	assert.False(t, c.Evaluate(chatty, nil))
+
+	// a source idle for the whole window starts from scratch
+	clock.Advance(10 * time.Second)
+	c.record(chattyPeer)
+	assert.False(t, c.Evaluate(chatty, nil))
+
+	// local paths never match
+	local := NewPath(nil, nlri, false, []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}, time.Now(), false)
+	assert.False(t, c.Evaluate(local, nil))
+}
+
+func TestSourceRateConditionMaxSources(t *testing.T) {
+	c, err := NewSourceRateCondition(oc.SourceRate{Threshold: 1, Window: 10, MaxSources: 2})
+	require.NoError(t, err)
+	clock := &fakeClock{now: time.Unix(1000, 0)}
+	c.now = clock.Now
+
+	nlri := bgp.NewIPAddrPrefix(24, "10.0.0.0")
+	peer := func(addr string) *PeerInfo {
+		return &PeerInfo{AS: 65001, Address: net.ParseIP(addr)}
+	}
+	newPath := func(addr string) *Path {
+		return NewPath(peer(addr), nlri, false, []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}, time.Now(), false)
+	}
+
+	c.record(peer("10.0.0.1"))
+	clock.Advance(time.Second)
+	c.record(peer("10.0.0.2"))
+	clock.Advance(time.Second)
+	// the least recently updated source is evicted
+	c.record(peer("10.0.0.3"))
+	assert.Len(t, c.sources, 2)
+	assert.NotContains(t, c.sources, "10.0.0.1")
+	c.record(peer("10.0.0.1"))
+	assert.NotContains(t, c.sources, "10.0.0.2")
+	c.record(peer("10.0.0.3"))
+	assert.True(t, c.Evaluate(newPath("10.0.0.3"), nil))
+	clock.Advance(time.Second)
+	c.record(peer("10.0.0.1"))
+	assert.True(t, c.Evaluate(newPath("10.0.0.1"), nil))
+
+	// idle sources are dropped before any active one
+	clock.Advance(9 * time.Second)
+	c.record(peer("10.0.0.4"))
+	assert.False(t, c.Evaluate(newPath("10.0.0.4"), nil))
+	assert.Len(t, c.sources, 2)
+	assert.Contains(t, c.sources, "10.0.0.1")
+	assert.NotContains(t, c.sources, "10.0.0.3")
+}
+
+func TestSourceRateConditionConfig(t *testing.T) {
+	c, err := NewSourceRateCondition(oc.SourceRate{})
+	assert.NoError(t, err)
+	assert.Nil(t, c)
+
+	_, err = NewSourceRateCondition(oc.SourceRate{Threshold: 10})
+	assert.Error(t, err)
+
+	// a missing threshold would match every source after its first update
+	_, err = NewSourceRateCondition(oc.SourceRate{Window: 60, MaxSources: 16})
+	assert.Error(t, err)
+
+	st, err := NewStatement(oc.Statement{
+		Name: "s1",
+		Conditions: oc.Conditions{
+			BgpConditions: oc.BgpConditions{
+				SourceRate: oc.SourceRate{Threshold: 100, Window: 60, MaxSources: 16},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, st.Conditions, 1)
+	assert.Equal(t, CONDITION_SOURCE_RATE, st.Conditions[0].Type())
+	assert.Equal(t, oc.SourceRate{Threshold: 100, Window: 60, MaxSources: 16}, st.ToConfig().Conditions.BgpConditions.SourceRate)
+}
+
+func TestRoutingPolicyToConfig(t *testing.T) {
+	ds := oc.DefinedSets{
+		PrefixSets: []oc.PrefixSet{{
+			PrefixSetName: "ps1",
+			PrefixList: []oc.Prefix{
+				{IpPrefix: "10.1.0.0/16", MasklengthRange: "16..16"},
+				{IpPrefix: "10.0.0.0/8", MasklengthRange: "24..32"},
+			},
+		}},
+		NeighborSets: []oc.NeighborSet{{
+			NeighborSetName:  "ns1",
+			NeighborInfoList: []string{"10.0.0.1/32", "172.16.0.0/16"},
+		}},
+		BgpDefinedSets: oc.BgpDefinedSets{
+			CommunitySets: []oc.CommunitySet{{
+				CommunitySetName: "cs1",
+				CommunityList:    []string{"^100:100$", "^200:.*$"},
+			}},
+			ExtCommunitySets: []oc.ExtCommunitySet{{
+				ExtCommunitySetName: "es1",
+				ExtCommunityList:    []string{"rt:^100:100$", "soo:^10.0.0.1:20$"},
+			}},
+			LargeCommunitySets: []oc.LargeCommunitySet{{
+				LargeCommunitySetName: "ls1",
+				LargeCommunityList:    []string{"^100:100:100$", "^200:"},
+			}},
+			AsPathSets: []oc.AsPathSet{{
+				AsPathSetName: "as1",
+				AsPathList:    []string{"^100", "_300_", "200$"},
+			}},
+		},
+	}
+	pd1 := oc.PolicyDefinition{
+		Name: "pd1",
+		Statements: []oc.Statement{{
+			Name: "s1",
+			Conditions: oc.Conditions{
+				MatchPrefixSet:   oc.MatchPrefixSet{PrefixSet: "ps1", MatchSetOptions: oc.MATCH_SET_OPTIONS_RESTRICTED_TYPE_ANY},
+				MatchNeighborSet: oc.MatchNeighborSet{NeighborSet: "ns1", MatchSetOptions: oc.MATCH_SET_OPTIONS_RESTRICTED_TYPE_INVERT},
+				BgpConditions: oc.BgpConditions{
+					MatchCommunitySet:      oc.MatchCommunitySet{CommunitySet: "cs1", MatchSetOptions: oc.MATCH_SET_OPTIONS_TYPE_ALL},
+					MatchExtCommunitySet:   oc.MatchExtCommunitySet{ExtCommunitySet: "es1", MatchSetOptions: oc.MATCH_SET_OPTIONS_TYPE_ANY},
+					MatchLargeCommunitySet: oc.MatchLargeCommunitySet{LargeCommunitySet: "ls1", MatchSetOptions: oc.MATCH_SET_OPTIONS_TYPE_INVERT},
+					MatchAsPathSet:         oc.MatchAsPathSet{AsPathSet: "as1", MatchSetOptions: oc.MATCH_SET_OPTIONS_TYPE_ANY},
+					NextHopInList:          []string{"10.0.0.1/32", "10.1.0.0/16"},
+					AfiSafiInList:          []oc.AfiSafiType{oc.AFI_SAFI_TYPE_IPV4_UNICAST},
+					CommunityCount:         oc.CommunityCount{Operator: oc.ATTRIBUTE_COMPARISON_ATTRIBUTE_GE, Value: 3},
+					AsPathLength:           oc.AsPathLength{Operator: oc.ATTRIBUTE_COMPARISON_ATTRIBUTE_LE, Value: 10},
+					RouteType:              oc.ROUTE_TYPE_EXTERNAL,
+					RpkiValidationResult:   oc.RPKI_VALIDATION_RESULT_TYPE_VALID,
+					SourceRate:             oc.SourceRate{Threshold: 10, Window: 5, MaxSources: 16},
+				},
+			},
+			Actions: oc.Actions{
+				RouteDisposition: oc.ROUTE_DISPOSITION_ACCEPT_ROUTE,
+				BgpActions: oc.BgpActions{
+					SetAsPathPrepend: oc.SetAsPathPrepend{As: "65000", RepeatN: 2},
+					SetCommunity: oc.SetCommunity{
+						Options:            "add",
+						SetCommunityMethod: oc.SetCommunityMethod{CommunitiesList: []string{"100:200"}},
+					},
+					SetExtCommunity: oc.SetExtCommunity{
+						Options:               "replace",
+						SetExtCommunityMethod: oc.SetExtCommunityMethod{CommunitiesList: []string{"rt:100:100"}},
+					},
+					SetLargeCommunity: oc.SetLargeCommunity{
+						Options:                 "remove",
+						SetLargeCommunityMethod: oc.SetLargeCommunityMethod{CommunitiesList: []string{"^100:100:100$"}},
+					},
+					SetMed:                     "+0",
+					SetLocalPref:               200,
+					SetNextHop:                 "self",
+					SetAspaValidationCommunity: oc.SetAspaValidationCommunity{Valid: "65000:1", Invalid: "65000:2"},
+				},
+			},
+		}, {
+			Name: "s2",
+			Actions: oc.Actions{
+				RouteDisposition: oc.ROUTE_DISPOSITION_NONE,
+				BgpActions: oc.BgpActions{
+					SetMed:     "-10",
+					SetNextHop: "10.0.0.254",
+				},
+			},
+		}},
+	}
+	// plain forms as written in configuration.md, and their canonical form
+	plainDs := oc.DefinedSets{
+		PrefixSets: []oc.PrefixSet{{
+			PrefixSetName: "ps2",
+			PrefixList:    []oc.Prefix{{IpPrefix: "10.0.0.0/8"}},
+		}},
+		NeighborSets: []oc.NeighborSet{{
+			NeighborSetName:  "ns2",
+			NeighborInfoList: []string{"192.168.10.2", "172.13.0.0/24"},
+		}},
+		BgpDefinedSets: oc.BgpDefinedSets{
+			CommunitySets: []oc.CommunitySet{{
+				CommunitySetName: "cs2",
+				CommunityList:    []string{"100:100", "no-export"},
+			}},
+			ExtCommunitySets: []oc.ExtCommunitySet{{
+				ExtCommunitySetName: "es2",
+				ExtCommunityList:    []string{"rt:100:100", "soo:200:200"},
+			}},
+			LargeCommunitySets: []oc.LargeCommunitySet{{
+				LargeCommunitySetName: "ls2",
+				LargeCommunityList:    []string{"100:100:100", "200:200:200", "^1:2:3", "1:2:4$"},
+			}},
+		},
+	}
+	canonicalDs := oc.DefinedSets{
+		PrefixSets: []oc.PrefixSet{{
+			PrefixSetName: "ps2",
+			PrefixList:    []oc.Prefix{{IpPrefix: "10.0.0.0/8", MasklengthRange: "8..8"}},
+		}},
+		NeighborSets: []oc.NeighborSet{{
+			NeighborSetName:  "ns2",
+			NeighborInfoList: []string{"192.168.10.2/32", "172.13.0.0/24"},
+		}},
+		BgpDefinedSets: oc.BgpDefinedSets{
+			CommunitySets: []oc.CommunitySet{{
+				CommunitySetName: "cs2",
+				CommunityList:    []string{"^100:100$", "^65535:65281$"},
+			}},
+			ExtCommunitySets: []oc.ExtCommunitySet{{
+				ExtCommunitySetName: "es2",
+				ExtCommunityList:    []string{"rt:^100:100$", "soo:^200:200$"},
+			}},
+			LargeCommunitySets: []oc.LargeCommunitySet{{
+				LargeCommunitySetName: "ls2",
+				LargeCommunityList:    []string{"^100:100:100$", "^200:200:200$", "^1:2:3$", "^1:2:4$"},
+			}},
+		},
+	}
+	pd2 := func(canonical bool) oc.PolicyDefinition {
+		options := oc.MatchSetOptionsType("")
+		restricted := oc.MatchSetOptionsRestrictedType("")
+		nexthop := "10.0.0.1"
+		if canonical {
+			options = oc.MATCH_SET_OPTIONS_TYPE_ANY
+			restricted = oc.MATCH_SET_OPTIONS_RESTRICTED_TYPE_ANY
+			nexthop = "10.0.0.1/32"
+		}
+		return oc.PolicyDefinition{
+			Name: "pd2",
+			Statements: []oc.Statement{{
+				Name: "s3",
+				Conditions: oc.Conditions{
+					MatchNeighborSet: oc.MatchNeighborSet{NeighborSet: "ns2", MatchSetOptions: restricted},
+					BgpConditions: oc.BgpConditions{
+						MatchCommunitySet:      oc.MatchCommunitySet{CommunitySet: "cs2", MatchSetOptions: options},
+						MatchExtCommunitySet:   oc.MatchExtCommunitySet{ExtCommunitySet: "es2", MatchSetOptions: options},
+						MatchLargeCommunitySet: oc.MatchLargeCommunitySet{LargeCommunitySet: "ls2", MatchSetOptions: options},
+						NextHopInList:          []string{nexthop},
+					},
+				},
+				Actions: oc.Actions{
+					RouteDisposition: oc.ROUTE_DISPOSITION_REJECT_ROUTE,
+				},
+			}},
+		}
+	}
+	merge := func(a, b oc.DefinedSets) oc.DefinedSets {
+		a.PrefixSets = append(append([]oc.PrefixSet{}, a.PrefixSets...), b.PrefixSets...)
+		a.NeighborSets = append(append([]oc.NeighborSet{}, a.NeighborSets...), b.NeighborSets...)
+		a.BgpDefinedSets.CommunitySets = append(append([]oc.CommunitySet{}, a.BgpDefinedSets.CommunitySets...), b.BgpDefinedSets.CommunitySets...)
+		a.BgpDefinedSets.ExtCommunitySets = append(append([]oc.ExtCommunitySet{}, a.BgpDefinedSets.ExtCommunitySets...), b.BgpDefinedSets.ExtCommunitySets...)
+		a.BgpDefinedSets.LargeCommunitySets = append(append([]oc.LargeCommunitySet{}, a.BgpDefinedSets.LargeCommunitySets...), b.BgpDefinedSets.LargeCommunitySets...)
+		return a
+	}
+
+	r := NewRoutingPolicy(logger)
+	err := r.reload(createRoutingPolicy(merge(ds, plainDs), pd2(false), pd1))
+	require.NoError(t, err)
+
+	// ignore the order of the lists which is not kept by the compiled sets
+	normalize := func(c *oc.RoutingPolicy) {
+		for i := range c.DefinedSets.PrefixSets {
+			l := c.DefinedSets.PrefixSets[i].PrefixList
+			sort.Slice(l, func(i, j int) bool { return l[i].IpPrefix < l[j].IpPrefix })
+		}
+		for i := range c.DefinedSets.BgpDefinedSets.AsPathSets {
+			sort.Strings(c.DefinedSets.BgpDefinedSets.AsPathSets[i].AsPathList)
+		}
+	}
+
+	// the plain forms are exported in their canonical form
+	got := r.ToConfig()
+	normalize(got)
+	want := createRoutingPolicy(merge(ds, canonicalDs), pd1, pd2(true))
+	normalize(&want)
+	assert.Equal(t, want, *got)
+
+	// the reconstructed config compiles to the same policies
+	r2 := NewRoutingPolicy(logger)
+	err = r2.reload(*got)
+	require.NoError(t, err)
+	again := r2.ToConfig()
+	normalize(again)
+	assert.Equal(t, got, again)
+}
+
+func TestRoutingPolicyRecordUpdate(t *testing.T) {
+	r := NewRoutingPolicy(logger)
+	require.NoError(t, r.reload(oc.RoutingPolicy{}))
+	assert.Empty(t, r.sourceRates)
+
+	p, err := NewPolicy(oc.PolicyDefinition{
+		Name: "p1",
+		Statements: []oc.Statement{{
+			Name: "s1",
+			Conditions: oc.Conditions{
+				BgpConditions: oc.BgpConditions{
+					SourceRate: oc.SourceRate{Threshold: 1, Window: 60},
+				},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, r.AddPolicy(p, false))
+	require.Len(t, r.sourceRates, 1)
+
+	source := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.1")}
+	path := NewPath(source, bgp.NewIPAddrPrefix(24, "10.0.0.0"), false, []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}, time.Now(), false)
+	r.RecordUpdate(source)
+	r.RecordUpdate(source)
+	assert.True(t, r.sourceRates[0].Evaluate(path, nil))
+
+	require.NoError(t, r.DeletePolicy(p, true, false, nil))
+	assert.Empty(t, r.sourceRates)
+	r.RecordUpdate(source)
+}
@@ -1019,6 +1019,43 @@ func (v RpkiValidationResultType) ToInt() int {
 	return i
 }
 
+// typedef for identity gobgp:aspa-validation-result-type.
+// indicate the validation result of ASPA based AS_PATH verification.
+type AspaValidationResultType string
+
+const (
+	ASPA_VALIDATION_RESULT_TYPE_UNKNOWN AspaValidationResultType = "unknown"
+	ASPA_VALIDATION_RESULT_TYPE_VALID   AspaValidationResultType = "valid"
+	ASPA_VALIDATION_RESULT_TYPE_INVALID AspaValidationResultType = "invalid"
+)
+
+var AspaValidationResultTypeToIntMap = map[AspaValidationResultType]int{
+	ASPA_VALIDATION_RESULT_TYPE_UNKNOWN: 0,
+	ASPA_VALIDATION_RESULT_TYPE_VALID:   1,
+	ASPA_VALIDATION_RESULT_TYPE_INVALID: 2,
+}
+
+var IntToAspaValidationResultTypeMap = map[int]AspaValidationResultType{
+	0: ASPA_VALIDATION_RESULT_TYPE_UNKNOWN,
+	1: ASPA_VALIDATION_RESULT_TYPE_VALID,
+	2: ASPA_VALIDATION_RESULT_TYPE_INVALID,
+}
+
+func (v AspaValidationResultType) Validate() error {
+	if _, ok := AspaValidationResultTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid AspaValidationResultType: %s", v)
+	}
+	return nil
+}
+
+func (v AspaValidationResultType) ToInt() int {
+	i, ok := AspaValidationResultTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
 // struct for container gobgp:state.
 type DynamicNeighborState struct {
 	// original -> gobgp:prefix
@@ -5151,6 +5188,32 @@ func (lhs *SetLargeCommunityMethod) Equal(rhs *SetLargeCommunityMethod) bool {
 	return true
 }
 
+// struct for container gobgp:set-aspa-validation-community.
+type SetAspaValidationCommunity struct {
+	// original -> gobgp:valid
+	Valid string `mapstructure:"valid" json:"valid,omitempty"`
+	// original -> gobgp:invalid
+	Invalid string `mapstructure:"invalid" json:"invalid,omitempty"`
+	// original -> gobgp:unknown
+	Unknown string `mapstructure:"unknown" json:"unknown,omitempty"`
+}
+
+func (lhs *SetAspaValidationCommunity) Equal(rhs *SetAspaValidationCommunity) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Valid != rhs.Valid {
+		return false
+	}
+	if lhs.Invalid != rhs.Invalid {
+		return false
+	}
+	if lhs.Unknown != rhs.Unknown {
+		return false
+	}
+	return true
+}
+
 // struct for container gobgp:set-large-community.
 type SetLargeCommunity struct {
 	// original -> gobgp:set-large-community-method
@@ -5359,6 +5422,8 @@ type BgpActions struct {
 	SetMed BgpSetMedType `mapstructure:"set-med" json:"set-med,omitempty"`
 	// original -> gobgp:set-large-community
 	SetLargeCommunity SetLargeCommunity `mapstructure:"set-large-community" json:"set-large-community,omitempty"`
+	// original -> gobgp:set-aspa-validation-community
+	SetAspaValidationCommunity SetAspaValidationCommunity `mapstructure:"set-aspa-validation-community" json:"set-aspa-validation-community,omitempty"`
 }
 
 func (lhs *BgpActions) Equal(rhs *BgpActions) bool {
@@ -5389,6 +5454,9 @@ func (lhs *BgpActions) Equal(rhs *BgpActions) bool {
 	if !lhs.SetLargeCommunity.Equal(&(rhs.SetLargeCommunity)) {
 		return false
 	}
+	if !lhs.SetAspaValidationCommunity.Equal(&(rhs.SetAspaValidationCommunity)) {
+		return false
+	}
 	return true
 }
 
@@ -5493,6 +5561,32 @@ func (lhs *AsPathLength) Equal(rhs *AsPathLength) bool {
 	return true
 }
 
+// struct for container gobgp:source-rate.
+type SourceRate struct {
+	// original -> gobgp:threshold
+	Threshold uint32 `mapstructure:"threshold" json:"threshold,omitempty"`
+	// original -> gobgp:window
+	Window uint32 `mapstructure:"window" json:"window,omitempty"`
+	// original -> gobgp:max-sources
+	MaxSources uint32 `mapstructure:"max-sources" json:"max-sources,omitempty"`
+}
+
+func (lhs *SourceRate) Equal(rhs *SourceRate) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Threshold != rhs.Threshold {
+		return false
+	}
+	if lhs.Window != rhs.Window {
+		return false
+	}
+	if lhs.MaxSources != rhs.MaxSources {
+		return false
+	}
+	return true
+}
+
 // struct for container bgp-pol:community-count.
 // Value and comparison operations for conditions based on the
 // number of communities in the route update.
@@ -5650,6 +5744,8 @@ type BgpConditions struct {
 	RpkiValidationResult RpkiValidationResultType `mapstructure:"rpki-validation-result" json:"rpki-validation-result,omitempty"`
 	// original -> gobgp:match-large-community-set
 	MatchLargeCommunitySet MatchLargeCommunitySet `mapstructure:"match-large-community-set" json:"match-large-community-set,omitempty"`
+	// original -> gobgp:source-rate
+	SourceRate SourceRate `mapstructure:"source-rate" json:"source-rate,omitempty"`
 }
 
 func (lhs *BgpConditions) Equal(rhs *BgpConditions) bool {
@@ -5705,6 +5801,9 @@ func (lhs *BgpConditions) Equal(rhs *BgpConditions) bool {
 	if !lhs.MatchLargeCommunitySet.Equal(&(rhs.MatchLargeCommunitySet)) {
 		return false
 	}
+	if !lhs.SourceRate.Equal(&(rhs.SourceRate)) {
+		return false
+	}
 	return true
 }
 
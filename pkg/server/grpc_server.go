@@ -1135,6 +1135,13 @@ func toStatementApi(s *oc.Statement) *api.Statement {
 			Type:   api.AsPathLength_Type(s.Conditions.BgpConditions.AsPathLength.Operator.ToInt()),
 		}
 	}
+	if r := s.Conditions.BgpConditions.SourceRate; r.Threshold != 0 || r.Window != 0 || r.MaxSources != 0 {
+		cs.SourceRate = &api.SourceRate{
+			Threshold:  r.Threshold,
+			Window:     r.Window,
+			MaxSources: r.MaxSources,
+		}
+	}
 	if s.Conditions.BgpConditions.MatchAsPathSet.AsPathSet != "" {
 		cs.AsPathSet = &api.MatchSet{
 			Type: api.MatchSet_Type(s.Conditions.BgpConditions.MatchAsPathSet.MatchSetOptions.ToInt()),
@@ -1252,6 +1259,17 @@ func toStatementApi(s *oc.Statement) *api.Statement {
 				Communities: s.Actions.BgpActions.SetLargeCommunity.SetLargeCommunityMethod.CommunitiesList,
 			}
 		}(),
+		AspaValidationCommunity: func() *api.AspaValidationCommunityAction {
+			c := s.Actions.BgpActions.SetAspaValidationCommunity
+			if c.Valid == "" && c.Invalid == "" && c.Unknown == "" {
+				return nil
+			}
+			return &api.AspaValidationCommunityAction{
+				Valid:   c.Valid,
+				Invalid: c.Invalid,
+				Unknown: c.Unknown,
+			}
+		}(),
 		Nexthop: func() *api.NexthopAction {
 			if len(string(s.Actions.BgpActions.SetNextHop)) == 0 {
 				return nil
@@ -1353,6 +1371,17 @@ func newCommunityCountConditionFromApiStruct(a *api.CommunityCount) (*table.Comm
 	})
 }
 
+func newSourceRateConditionFromApiStruct(a *api.SourceRate) (*table.SourceRateCondition, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return table.NewSourceRateCondition(oc.SourceRate{
+		Threshold:  a.Threshold,
+		Window:     a.Window,
+		MaxSources: a.MaxSources,
+	})
+}
+
 func newAsPathLengthConditionFromApiStruct(a *api.AsPathLength) (*table.AsPathLengthCondition, error) {
 	if a == nil {
 		return nil, nil
@@ -1514,6 +1543,17 @@ func newLargeCommunityActionFromApiStruct(a *api.CommunityAction) (*table.LargeC
 	})
 }
 
+func newAspaCommunityActionFromApiStruct(a *api.AspaValidationCommunityAction) (*table.AspaCommunityAction, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return table.NewAspaCommunityAction(oc.SetAspaValidationCommunity{
+		Valid:   a.Valid,
+		Invalid: a.Invalid,
+		Unknown: a.Unknown,
+	})
+}
+
 func newMedActionFromApiStruct(a *api.MedAction) (*table.MedAction, error) {
 	if a == nil {
 		return nil, nil
@@ -1606,6 +1646,9 @@ func newStatementFromApiStruct(a *api.Statement) (*table.Statement, error) {
 			func() (table.Condition, error) {
 				return newAfiSafiInConditionFromApiStruct(a.Conditions.AfiSafiIn)
 			},
+			func() (table.Condition, error) {
+				return newSourceRateConditionFromApiStruct(a.Conditions.SourceRate)
+			},
 		}
 		cs = make([]table.Condition, 0, len(cfs))
 		for _, f := range cfs {
@@ -1633,6 +1676,9 @@ func newStatementFromApiStruct(a *api.Statement) (*table.Statement, error) {
 			func() (table.Action, error) {
 				return newLargeCommunityActionFromApiStruct(a.Actions.LargeCommunity)
 			},
+			func() (table.Action, error) {
+				return newAspaCommunityActionFromApiStruct(a.Actions.AspaValidationCommunity)
+			},
 			func() (table.Action, error) {
 				return newMedActionFromApiStruct(a.Actions.Med)
 			},
@@ -8,8 +8,10 @@ import (
 	api "github.com/osrg/gobgp/v3/api"
 	"github.com/osrg/gobgp/v3/internal/pkg/table"
 	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
 	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -160,3 +162,30 @@ func anyAttrs(attrs []bgp.PathAttributeInterface) []*anypb.Any {
 	anyPattrs, _ := apiutil.MarshalPathAttributes(attrs)
 	return anyPattrs
 }
+
+func TestStatementApiRoundTrip(t *testing.T) {
+	stmt := oc.Statement{
+		Name: "s1",
+		Conditions: oc.Conditions{
+			BgpConditions: oc.BgpConditions{
+				SourceRate: oc.SourceRate{Threshold: 1000, Window: 60, MaxSources: 1024},
+			},
+		},
+		Actions: oc.Actions{
+			RouteDisposition: oc.ROUTE_DISPOSITION_REJECT_ROUTE,
+			BgpActions: oc.BgpActions{
+				SetAspaValidationCommunity: oc.SetAspaValidationCommunity{Valid: "no-export", Invalid: "65000:2"},
+			},
+		},
+	}
+
+	for _, a := range []*api.Statement{toStatementApi(&stmt), table.ToPolicyApi(&oc.PolicyDefinition{Statements: []oc.Statement{stmt}}).Statements[0]} {
+		assert.Equal(t, &api.SourceRate{Threshold: 1000, Window: 60, MaxSources: 1024}, a.Conditions.SourceRate)
+		assert.Equal(t, &api.AspaValidationCommunityAction{Valid: "no-export", Invalid: "65000:2"}, a.Actions.AspaValidationCommunity)
+
+		s, err := newStatementFromApiStruct(a)
+		require.NoError(t, err)
+		assert.Equal(t, stmt.Conditions.BgpConditions.SourceRate, s.ToConfig().Conditions.BgpConditions.SourceRate)
+		assert.Equal(t, stmt.Actions.BgpActions.SetAspaValidationCommunity, s.ToConfig().Actions.BgpActions.SetAspaValidationCommunity)
+	}
+}
@@ -140,6 +140,7 @@ type options struct {
 	grpcAddress string
 	grpcOption  []grpc.ServerOption
 	logger      log.Logger
+	aspa        AspaVerifier
 }
 
 type ServerOption func(*options)
@@ -162,6 +163,18 @@ func LoggerOption(logger log.Logger) ServerOption {
 	}
 }
 
+// AspaVerifier is defined alongside the policy engine which calls it.
+type AspaVerifier = table.AspaVerifier
+
+// AspaVerifierOption registers the verifier used by the
+// set-aspa-validation-community policy action. Without a verifier, the
+// action leaves the paths untouched.
+func AspaVerifierOption(v AspaVerifier) ServerOption {
+	return func(o *options) {
+		o.aspa = v
+	}
+}
+
 type BgpServer struct {
 	apiServer    *server
 	bgpConfig    oc.Bgp
@@ -181,6 +194,7 @@ type BgpServer struct {
 	bmpManager   *bmpClientManager
 	mrtManager   *mrtManager
 	roaTable     *table.ROATable
+	aspa         table.AspaVerifier
 	uuidMap      map[string]uuid.UUID
 	logger       log.Logger
 }
@@ -205,6 +219,7 @@ func NewBgpServer(opt ...ServerOption) *BgpServer {
 		uuidMap:      make(map[string]uuid.UUID),
 		roaManager:   newROAManager(roaTable, logger),
 		roaTable:     roaTable,
+		aspa:         opts.aspa,
 		logger:       logger,
 	}
 	s.bmpManager = newBmpClientManager(s)
@@ -770,6 +785,7 @@ func (s *BgpServer) filterpath(peer *peer, path, old *table.Path) *table.Path {
 		return nil
 	}
 	options.Validate = s.roaTable.Validate
+	options.Aspa = s.aspa
 	path = peer.policy.ApplyPolicy(peer.TableID(), table.POLICY_DIRECTION_EXPORT, path, options)
 	// When 'path' is filtered (path == nil), check 'old' has been sent to this peer.
 	// If it has, send withdrawal to the peer.
@@ -1098,6 +1114,7 @@ func (s *BgpServer) sendSecondaryRoutes(peer *peer, newPath *table.Path, dsts []
 			return nil
 		}
 		options.Validate = s.roaTable.Validate
+		options.Aspa = s.aspa
 		path = peer.policy.ApplyPolicy(peer.TableID(), table.POLICY_DIRECTION_EXPORT, path, options)
 		if path != nil {
 			return s.postFilterpath(peer, path)
@@ -1212,6 +1229,7 @@ func (s *BgpServer) propagateUpdate(peer *peer, pathList []*table.Path) {
 
 		policyOptions := &table.PolicyOptions{
 			Validate: s.roaTable.Validate,
+			Aspa:     s.aspa,
 		}
 
 		if !rs && peer != nil {
@@ -1730,6 +1748,10 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 			}
 			if m.Header.Type == bgp.BGP_MSG_UPDATE {
 				s.notifyPrePolicyUpdateWatcher(peer, pathList, m, e.timestamp, e.payload)
+				peer.fsm.lock.RLock()
+				peerInfo := peer.fsm.peerInfo
+				peer.fsm.lock.RUnlock()
+				s.policy.RecordUpdate(peerInfo)
 			}
 
 			if len(pathList) > 0 {
@@ -2693,6 +2715,7 @@ func (s *BgpServer) getAdjRib(addr string, family bgp.RouteFamily, in bool, enab
 				for _, path := range peer.adjRibIn.PathList([]bgp.RouteFamily{family}, true) {
 					options := &table.PolicyOptions{
 						Validate: s.roaTable.Validate,
+						Aspa:     s.aspa,
 					}
 					if p := s.policy.ApplyPolicy(peer.TableID(), table.POLICY_DIRECTION_IMPORT, path, options); p == nil {
 						filtered[path.GetLocalKey()] = table.PolicyFiltered
@@ -2711,6 +2734,7 @@ func (s *BgpServer) getAdjRib(addr string, family bgp.RouteFamily, in bool, enab
 						continue
 					}
 					options.Validate = s.roaTable.Validate
+					options.Aspa = s.aspa
 					p := peer.policy.ApplyPolicy(peer.TableID(), table.POLICY_DIRECTION_EXPORT, path, options)
 					if p == nil {
 						filtered[path.GetLocalKey()] = table.PolicyFiltered
@@ -695,6 +695,180 @@ func TestListPathEnableFiltered(test *testing.T) {
 	assert.Equal(1, filtered)
 }
 
+func TestSourceRateSoftReset(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	server1 := runNewServer(t, 1, "1.1.1.1", 10179)
+	defer server1.StopBgp(ctx, &api.StopBgpRequest{})
+	server2 := runNewServer(t, 2, "2.2.2.2", -1)
+	defer server2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	// reject the routes of a source sending more than 5 updates a minute
+	p, err := table.NewPolicy(oc.PolicyDefinition{
+		Name: "p1",
+		Statements: []oc.Statement{{
+			Name: "s1",
+			Conditions: oc.Conditions{
+				BgpConditions: oc.BgpConditions{
+					SourceRate: oc.SourceRate{Threshold: 5, Window: 60},
+				},
+			},
+			Actions: oc.Actions{
+				RouteDisposition: oc.ROUTE_DISPOSITION_REJECT_ROUTE,
+			},
+		}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, server1.policy.AddPolicy(p, false))
+	require.NoError(t, server1.policy.AddPolicyAssignment(table.GLOBAL_RIB_NAME, table.POLICY_DIRECTION_IMPORT, []*oc.PolicyDefinition{{Name: "p1"}}, table.ROUTE_TYPE_ACCEPT))
+
+	ch := make(chan struct{})
+	go waitEstablished(server1, ch)
+	err = server1.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 2},
+		Transport: &api.Transport{PassiveMode: true},
+	}})
+	require.NoError(t, err)
+	err = server2.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 1},
+		Transport: &api.Transport{RemotePort: 10179},
+		Timers: &api.Timers{Config: &api.TimersConfig{
+			ConnectRetry:           1,
+			IdleHoldTimeAfterReset: 1,
+		}},
+	}})
+	require.NoError(t, err)
+	<-ch
+
+	family := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+	a1, _ := apb.New(&api.OriginAttribute{Origin: 0})
+	a2, _ := apb.New(&api.NextHopAttribute{NextHop: "10.0.0.1"})
+	for i := 1; i <= 3; i++ {
+		nlri, _ := apb.New(&api.IPAddressPrefix{Prefix: fmt.Sprintf("10.%d.0.0", i), PrefixLen: 24})
+		_, err = server2.AddPath(ctx, &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path:      &api.Path{Family: family, Nlri: nlri, Pattrs: []*apb.Any{a1, a2}},
+		})
+		require.NoError(t, err)
+	}
+
+	count := func() int {
+		n := 0
+		server1.ListPath(ctx, &api.ListPathRequest{TableType: api.TableType_GLOBAL, Family: family}, func(d *api.Destination) {
+			n++
+		})
+		return n
+	}
+	for count() != 3 {
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// re-evaluating the received routes does not count as updates
+	reset := &api.ResetPeerRequest{Address: "127.0.0.1", Soft: true, Direction: api.ResetPeerRequest_IN}
+	for i := 0; i < 5; i++ {
+		require.NoError(t, server1.ResetPeer(ctx, reset))
+	}
+	assert.Equal(3, count())
+
+	// whereas the received updates do
+	for i := 0; i < 5; i++ {
+		server1.policy.RecordUpdate(&table.PeerInfo{Address: net.ParseIP("127.0.0.1")})
+	}
+	require.NoError(t, server1.ResetPeer(ctx, reset))
+	assert.Equal(0, count())
+}
+
+type mockAspaVerifier struct {
+	result oc.AspaValidationResultType
+}
+
+func (v *mockAspaVerifier) Verify(aspath *bgp.PathAttributeAsPath) oc.AspaValidationResultType {
+	if aspath == nil {
+		return oc.ASPA_VALIDATION_RESULT_TYPE_UNKNOWN
+	}
+	return v.result
+}
+
+func TestAspaCommunityPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	server1 := NewBgpServer(AspaVerifierOption(&mockAspaVerifier{result: oc.ASPA_VALIDATION_RESULT_TYPE_INVALID}))
+	go server1.Serve()
+	err := server1.StartBgp(ctx, &api.StartBgpRequest{
+		Global: &api.Global{Asn: 1, RouterId: "1.1.1.1", ListenPort: 10179},
+	})
+	require.NoError(t, err)
+	defer server1.StopBgp(ctx, &api.StopBgpRequest{})
+	server2 := runNewServer(t, 2, "2.2.2.2", -1)
+	defer server2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	p, err := table.NewPolicy(oc.PolicyDefinition{
+		Name: "p1",
+		Statements: []oc.Statement{{
+			Name: "s1",
+			Actions: oc.Actions{
+				RouteDisposition: oc.ROUTE_DISPOSITION_ACCEPT_ROUTE,
+				BgpActions: oc.BgpActions{
+					SetAspaValidationCommunity: oc.SetAspaValidationCommunity{
+						Valid:   "65000:1",
+						Invalid: "65000:2",
+					},
+				},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, server1.policy.AddPolicy(p, false))
+	require.NoError(t, server1.policy.AddPolicyAssignment(table.GLOBAL_RIB_NAME, table.POLICY_DIRECTION_IMPORT, []*oc.PolicyDefinition{{Name: "p1"}}, table.ROUTE_TYPE_ACCEPT))
+
+	ch := make(chan struct{})
+	go waitEstablished(server1, ch)
+	err = server1.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 2},
+		Transport: &api.Transport{PassiveMode: true},
+	}})
+	require.NoError(t, err)
+	err = server2.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 1},
+		Transport: &api.Transport{RemotePort: 10179},
+		Timers: &api.Timers{Config: &api.TimersConfig{
+			ConnectRetry:           1,
+			IdleHoldTimeAfterReset: 1,
+		}},
+	}})
+	require.NoError(t, err)
+	<-ch
+
+	family := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+	nlri, _ := apb.New(&api.IPAddressPrefix{Prefix: "10.1.0.0", PrefixLen: 24})
+	a1, _ := apb.New(&api.OriginAttribute{Origin: 0})
+	a2, _ := apb.New(&api.NextHopAttribute{NextHop: "10.0.0.1"})
+	_, err = server2.AddPath(ctx, &api.AddPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Path:      &api.Path{Family: family, Nlri: nlri, Pattrs: []*apb.Any{a1, a2}},
+	})
+	require.NoError(t, err)
+
+	var paths []*api.Path
+	for len(paths) == 0 {
+		time.Sleep(100 * time.Millisecond)
+		server1.ListPath(ctx, &api.ListPathRequest{TableType: api.TableType_GLOBAL, Family: family}, func(d *api.Destination) {
+			paths = append(paths, d.Paths...)
+		})
+	}
+	require.Len(t, paths, 1)
+	var comms []uint32
+	for _, attr := range paths[0].GetPattrs() {
+		m, err := attr.UnmarshalNew()
+		require.NoError(t, err)
+		if m, ok := m.(*api.CommunitiesAttribute); ok {
+			comms = m.GetCommunities()
+		}
+	}
+	assert.Equal(t, []uint32{65000<<16 | 2}, comms)
+}
+
 func TestMonitor(test *testing.T) {
 	assert := assert.New(test)
 	s := NewBgpServer()